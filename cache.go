@@ -0,0 +1,342 @@
+package digitaloceanexporter
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/alecthomas/kingpin/v2"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"golang.org/x/sync/errgroup"
+)
+
+// RefreshInterval is how often the Cache re-fetches every resource from the
+// DigitalOcean API in the background.
+var RefreshInterval = kingpin.Flag("do.refresh-interval", "How often to refresh cached DigitalOcean resources.").Default("60s").Duration()
+
+var (
+	apiRequestsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "api",
+		Name:      "requests_total",
+		Help:      "Total number of requests made to the DigitalOcean API, by resource and result.",
+	}, []string{"resource", "result"})
+
+	apiRequestDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "api",
+		Name:      "request_duration_seconds",
+		Help:      "Duration of requests made to the DigitalOcean API, by resource.",
+	}, []string{"resource"})
+
+	cacheLastRefresh = promauto.NewGauge(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "cache",
+		Name:      "last_refresh_timestamp_seconds",
+		Help:      "Unix timestamp of the last completed cache refresh.",
+	})
+
+	cacheRefreshErrors = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "cache",
+		Name:      "refresh_errors_total",
+		Help:      "Total number of resource fetches that failed during a cache refresh.",
+	})
+)
+
+// A cacheEntry holds the last cached value for a resource along with enough
+// state to tell "never successfully fetched" apart from "legitimately
+// empty".
+type cacheEntry struct {
+	value         interface{}
+	err           error // error from the most recent fetch attempt, if any
+	everSucceeded bool
+}
+
+// A Cache wraps a DigitalOceanSource, refreshing only the resources needed
+// by the enabled collectors in the background on a fixed interval instead of
+// on every Prometheus scrape. This decouples scrape latency from
+// DigitalOcean API latency and rate limits. Cache itself implements
+// DigitalOceanSource, so it is a drop-in replacement for the underlying
+// source.
+type Cache struct {
+	source           DigitalOceanSource
+	logger           *slog.Logger
+	enabledResources map[string]bool
+
+	mu      sync.RWMutex
+	results map[string]cacheEntry
+}
+
+// NewCache creates a Cache around source and starts a background goroutine
+// that refreshes every resource required by enabledCollectors every
+// interval, stopping when ctx is cancelled. logger receives a debug-level
+// entry for every DO API call made during a refresh. Resources belonging to
+// collectors that aren't enabled are never fetched, preserving chunk0-1's
+// goal of not spending API calls (and rate limit) on disabled collectors.
+func NewCache(ctx context.Context, source DigitalOceanSource, interval time.Duration, logger *slog.Logger, enabledCollectors map[string]Collector) *Cache {
+	enabledResources := make(map[string]bool)
+	for name := range enabledCollectors {
+		for _, resource := range collectorResources[name] {
+			enabledResources[resource] = true
+		}
+	}
+
+	c := &Cache{
+		source:           source,
+		logger:           logger,
+		enabledResources: enabledResources,
+		results:          make(map[string]cacheEntry),
+	}
+
+	go c.run(ctx, interval)
+
+	return c
+}
+
+// resourceFetch describes a single DigitalOceanSource call to be refreshed
+// and the key its result is cached under.
+type resourceFetch struct {
+	resource string
+	fetch    func() (interface{}, error)
+}
+
+// collectorResources maps each registered collector name to the cache
+// resource keys it needs. It must be kept in sync with allFetches and with
+// the registerCollector calls in the *_collector.go files.
+var collectorResources = map[string][]string{
+	"droplets":       {"droplets", "droplet_details"},
+	"floating_ips":   {"floating_ips", "floating_ip_details"},
+	"load_balancers": {"load_balancers", "load_balancer_details"},
+	"tags":           {"tags"},
+	"volumes":        {"volumes", "volume_details"},
+	"kubernetes":     {"kubernetes_clusters", "kubernetes_node_pools"},
+	"databases":      {"databases", "database_details"},
+	"spaces":         {"spaces_buckets"},
+	"snapshots":      {"snapshots"},
+	"images":         {"images"},
+	"domains":        {"domains", "domain_records"},
+	"projects":       {"projects"},
+	"firewalls":      {"firewalls"},
+	"cdn":            {"cdn_endpoints"},
+	"vpcs":           {"vpcs"},
+}
+
+func (c *Cache) allFetches() []resourceFetch {
+	return []resourceFetch{
+		{"droplets", func() (interface{}, error) { return c.source.Droplets() }},
+		{"droplet_details", func() (interface{}, error) { return c.source.DropletDetails() }},
+		{"floating_ips", func() (interface{}, error) { return c.source.FloatingIPs() }},
+		{"floating_ip_details", func() (interface{}, error) { return c.source.FloatingIPDetails() }},
+		{"load_balancers", func() (interface{}, error) { return c.source.LoadBalancers() }},
+		{"load_balancer_details", func() (interface{}, error) { return c.source.LoadBalancerDetails() }},
+		{"tags", func() (interface{}, error) { return c.source.Tags() }},
+		{"volumes", func() (interface{}, error) { return c.source.Volumes() }},
+		{"volume_details", func() (interface{}, error) { return c.source.VolumeDetails() }},
+		{"kubernetes_clusters", func() (interface{}, error) { return c.source.KubernetesClusters() }},
+		{"kubernetes_node_pools", func() (interface{}, error) { return c.source.KubernetesNodePools() }},
+		{"databases", func() (interface{}, error) { return c.source.Databases() }},
+		{"database_details", func() (interface{}, error) { return c.source.DatabaseDetails() }},
+		{"spaces_buckets", func() (interface{}, error) { return c.source.SpacesBuckets() }},
+		{"snapshots", func() (interface{}, error) { return c.source.Snapshots() }},
+		{"images", func() (interface{}, error) { return c.source.Images() }},
+		{"domains", func() (interface{}, error) { return c.source.Domains() }},
+		{"domain_records", func() (interface{}, error) { return c.source.DomainRecords() }},
+		{"projects", func() (interface{}, error) { return c.source.Projects() }},
+		{"firewalls", func() (interface{}, error) { return c.source.Firewalls() }},
+		{"cdn_endpoints", func() (interface{}, error) { return c.source.CDNEndpoints() }},
+		{"vpcs", func() (interface{}, error) { return c.source.VPCs() }},
+	}
+}
+
+// fetches returns only the resource fetches required by the collectors this
+// Cache was constructed with.
+func (c *Cache) fetches() []resourceFetch {
+	var enabled []resourceFetch
+	for _, f := range c.allFetches() {
+		if c.enabledResources[f.resource] {
+			enabled = append(enabled, f)
+		}
+	}
+	return enabled
+}
+
+// run refreshes the cache immediately and then every interval until ctx is
+// cancelled.
+func (c *Cache) run(ctx context.Context, interval time.Duration) {
+	c.refresh(ctx)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			c.refresh(ctx)
+		}
+	}
+}
+
+// refresh fetches every resource required by an enabled collector in
+// parallel and stores the results, recording API request and cache refresh
+// metrics along the way.
+func (c *Cache) refresh(ctx context.Context) {
+	g, _ := errgroup.WithContext(ctx)
+
+	for _, f := range c.fetches() {
+		f := f
+		g.Go(func() error {
+			start := time.Now()
+			v, err := f.fetch()
+			latency := time.Since(start)
+			apiRequestDuration.WithLabelValues(f.resource).Observe(latency.Seconds())
+
+			c.mu.Lock()
+			entry := c.results[f.resource]
+			if err != nil {
+				apiRequestsTotal.WithLabelValues(f.resource, "error").Inc()
+				cacheRefreshErrors.Inc()
+				c.logger.Debug("DigitalOcean API call failed", "resource", f.resource, "latency", latency, "result", "error", "err", err)
+				entry.err = err
+			} else {
+				apiRequestsTotal.WithLabelValues(f.resource, "success").Inc()
+				c.logger.Debug("DigitalOcean API call succeeded", "resource", f.resource, "latency", latency, "result", "success")
+				entry.value = v
+				entry.err = nil
+				entry.everSucceeded = true
+			}
+			c.results[f.resource] = entry
+			c.mu.Unlock()
+
+			return err
+		})
+	}
+
+	// Errors are recorded per-resource above; a failed resource simply keeps
+	// serving its last good value (see cacheEntry) until the next successful
+	// refresh, but its error is preserved for resources that have never
+	// succeeded so callers can tell that apart from a legitimately empty
+	// result.
+	_ = g.Wait()
+
+	cacheLastRefresh.SetToCurrentTime()
+}
+
+// cacheGet returns the cached value for resource, type-asserted to T. It
+// returns an error if the resource has never been fetched, or if it has
+// never been fetched successfully.
+func cacheGet[T any](c *Cache, resource string) (T, error) {
+	c.mu.RLock()
+	entry, ok := c.results[resource]
+	c.mu.RUnlock()
+
+	var zero T
+	if !ok {
+		return zero, fmt.Errorf("%s: not yet refreshed", resource)
+	}
+	if !entry.everSucceeded {
+		return zero, entry.err
+	}
+
+	v, ok := entry.value.(T)
+	if !ok {
+		return zero, fmt.Errorf("%s: unexpected cached value type %T", resource, entry.value)
+	}
+	return v, nil
+}
+
+func (c *Cache) Droplets() (map[DropletCounter]int, error) {
+	return cacheGet[map[DropletCounter]int](c, "droplets")
+}
+
+func (c *Cache) DropletDetails() ([]DropletInfo, error) {
+	return cacheGet[[]DropletInfo](c, "droplet_details")
+}
+
+func (c *Cache) FloatingIPs() (map[FlipCounter]int, error) {
+	return cacheGet[map[FlipCounter]int](c, "floating_ips")
+}
+
+func (c *Cache) FloatingIPDetails() ([]FloatingIPInfo, error) {
+	return cacheGet[[]FloatingIPInfo](c, "floating_ip_details")
+}
+
+func (c *Cache) LoadBalancers() (map[LoadBalancerCounter]int, error) {
+	return cacheGet[map[LoadBalancerCounter]int](c, "load_balancers")
+}
+
+func (c *Cache) LoadBalancerDetails() ([]LoadBalancerInfo, error) {
+	return cacheGet[[]LoadBalancerInfo](c, "load_balancer_details")
+}
+
+func (c *Cache) Tags() (map[TagCounter]int, error) {
+	return cacheGet[map[TagCounter]int](c, "tags")
+}
+
+func (c *Cache) Volumes() (map[VolumeCounter]int, error) {
+	return cacheGet[map[VolumeCounter]int](c, "volumes")
+}
+
+func (c *Cache) VolumeDetails() ([]VolumeInfo, error) {
+	return cacheGet[[]VolumeInfo](c, "volume_details")
+}
+
+func (c *Cache) KubernetesClusters() (map[KubernetesClusterCounter]int, error) {
+	return cacheGet[map[KubernetesClusterCounter]int](c, "kubernetes_clusters")
+}
+
+func (c *Cache) KubernetesNodePools() (map[KubernetesNodePoolCounter]int, error) {
+	return cacheGet[map[KubernetesNodePoolCounter]int](c, "kubernetes_node_pools")
+}
+
+func (c *Cache) Databases() (map[DatabaseCounter]int, error) {
+	return cacheGet[map[DatabaseCounter]int](c, "databases")
+}
+
+func (c *Cache) DatabaseDetails() ([]DatabaseInfo, error) {
+	return cacheGet[[]DatabaseInfo](c, "database_details")
+}
+
+func (c *Cache) SpacesBuckets() (map[SpacesBucketCounter]int, error) {
+	return cacheGet[map[SpacesBucketCounter]int](c, "spaces_buckets")
+}
+
+func (c *Cache) Snapshots() (map[SnapshotCounter]int, error) {
+	return cacheGet[map[SnapshotCounter]int](c, "snapshots")
+}
+
+func (c *Cache) Images() (map[ImageCounter]int, error) {
+	return cacheGet[map[ImageCounter]int](c, "images")
+}
+
+func (c *Cache) Domains() (map[DomainCounter]int, error) {
+	return cacheGet[map[DomainCounter]int](c, "domains")
+}
+
+func (c *Cache) DomainRecords() (map[DomainRecordCounter]int, error) {
+	return cacheGet[map[DomainRecordCounter]int](c, "domain_records")
+}
+
+func (c *Cache) Projects() (map[ProjectCounter]int, error) {
+	return cacheGet[map[ProjectCounter]int](c, "projects")
+}
+
+func (c *Cache) Firewalls() (map[FirewallCounter]int, error) {
+	return cacheGet[map[FirewallCounter]int](c, "firewalls")
+}
+
+func (c *Cache) CDNEndpoints() (map[CDNEndpointCounter]int, error) {
+	return cacheGet[map[CDNEndpointCounter]int](c, "cdn_endpoints")
+}
+
+func (c *Cache) VPCs() (map[VPCCounter]int, error) {
+	return cacheGet[map[VPCCounter]int](c, "vpcs")
+}
+
+// Verify that Cache implements the DigitalOceanSource interface.
+var _ DigitalOceanSource = &Cache{}
@@ -0,0 +1,74 @@
+package digitaloceanexporter
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestCacheGet(t *testing.T) {
+	fetchErr := errors.New("boom")
+
+	t.Run("never fetched", func(t *testing.T) {
+		c := &Cache{results: map[string]cacheEntry{}}
+
+		_, err := cacheGet[int](c, "droplets")
+		if err == nil {
+			t.Fatal("expected an error for a resource that has never been fetched")
+		}
+	})
+
+	t.Run("never succeeded", func(t *testing.T) {
+		c := &Cache{results: map[string]cacheEntry{
+			"droplets": {err: fetchErr, everSucceeded: false},
+		}}
+
+		_, err := cacheGet[int](c, "droplets")
+		if !errors.Is(err, fetchErr) {
+			t.Fatalf("got error %v, want %v", err, fetchErr)
+		}
+	})
+
+	t.Run("stale but served", func(t *testing.T) {
+		// A resource that succeeded at least once keeps serving its last
+		// good value with a nil error even if the most recent refresh
+		// failed; see the comment on cacheGet.
+		c := &Cache{results: map[string]cacheEntry{
+			"droplets": {value: 42, err: fetchErr, everSucceeded: true},
+		}}
+
+		v, err := cacheGet[int](c, "droplets")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if v != 42 {
+			t.Fatalf("got %d, want 42", v)
+		}
+	})
+}
+
+func TestCacheFetchesFiltersByEnabledResources(t *testing.T) {
+	c := &Cache{enabledResources: map[string]bool{
+		"droplets": true,
+		"vpcs":     true,
+	}}
+
+	got := map[string]bool{}
+	for _, f := range c.fetches() {
+		got[f.resource] = true
+	}
+
+	want := map[string]bool{"droplets": true, "vpcs": true}
+	if len(got) != len(want) {
+		t.Fatalf("got %d fetches %v, want %d %v", len(got), got, len(want), want)
+	}
+	for resource := range want {
+		if !got[resource] {
+			t.Errorf("expected fetches() to include %q", resource)
+		}
+	}
+	for resource := range got {
+		if !want[resource] {
+			t.Errorf("fetches() included %q for a disabled resource", resource)
+		}
+	}
+}
@@ -0,0 +1,58 @@
+package digitaloceanexporter
+
+import "github.com/prometheus/client_golang/prometheus"
+
+func init() {
+	registerCollector("cdn", true, newCDNCollector)
+}
+
+// A CDNEndpointCounter is a unique combination of properties that identify
+// one or more CDN endpoints.
+type CDNEndpointCounter struct {
+	region string
+}
+
+// A cdnCollector collects metrics about CDN endpoints.
+type cdnCollector struct {
+	desc *prometheus.Desc
+
+	endpoints map[CDNEndpointCounter]int
+}
+
+func newCDNCollector() Collector {
+	return &cdnCollector{
+		desc: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, "cdn_endpoints", "count"),
+			"Number of CDN endpoints by origin region.",
+			[]string{"region"},
+			nil,
+		),
+	}
+}
+
+func (c *cdnCollector) Name() string { return "cdn" }
+
+func (c *cdnCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.desc
+}
+
+func (c *cdnCollector) Update(dos DigitalOceanSource) error {
+	endpoints, err := dos.CDNEndpoints()
+	if err != nil {
+		return err
+	}
+
+	c.endpoints = endpoints
+	return nil
+}
+
+func (c *cdnCollector) Collect(ch chan<- prometheus.Metric) {
+	for e, count := range c.endpoints {
+		ch <- prometheus.MustNewConstMetric(
+			c.desc,
+			prometheus.GaugeValue,
+			float64(count),
+			e.region,
+		)
+	}
+}
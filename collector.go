@@ -0,0 +1,74 @@
+package digitaloceanexporter
+
+import (
+	"fmt"
+
+	"github.com/alecthomas/kingpin/v2"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// A Collector is a single, independently toggleable source of DigitalOcean
+// metrics. Each resource type (droplets, floating IPs, ...) implements this
+// interface and registers itself via init(), following the pattern
+// node_exporter uses for its per-collector flags.
+type Collector interface {
+	// Name returns the unique, flag-friendly name of the collector, e.g.
+	// "droplets".
+	Name() string
+	// Describe sends the descriptors of the metrics this collector exposes.
+	Describe(ch chan<- *prometheus.Desc)
+	// Update fetches the latest data for this collector from the given
+	// DigitalOceanSource.
+	Update(dos DigitalOceanSource) error
+	// Collect sends the metric values gathered by the last Update.
+	Collect(ch chan<- prometheus.Metric)
+}
+
+// factories holds a constructor for every collector that has registered
+// itself via registerCollector. It is populated by init() functions in the
+// individual <resource>_collector.go files.
+var factories = map[string]func() Collector{}
+
+// collectorFlags holds the --collector.<name> flag for every registered
+// collector, keyed by name.
+var collectorFlags = map[string]*bool{}
+
+// registerCollector registers a Collector factory under name and wires up
+// its --collector.<name> / --no-collector.<name> flag. It must be called
+// from an init() function.
+func registerCollector(name string, enabledByDefault bool, factory func() Collector) {
+	if _, ok := factories[name]; ok {
+		panic(fmt.Sprintf("collector %q registered twice", name))
+	}
+
+	helpDefault := "disabled"
+	if enabledByDefault {
+		helpDefault = "enabled"
+	}
+
+	flagName := fmt.Sprintf("collector.%s", name)
+	flagHelp := fmt.Sprintf("Enable the %s collector (%s by default).", name, helpDefault)
+	collectorFlags[name] = kingpin.Flag(flagName, flagHelp).Default(fmt.Sprintf("%t", enabledByDefault)).Bool()
+
+	factories[name] = factory
+}
+
+// boolToFloat64 converts a bool to a Prometheus-friendly 1 or 0.
+func boolToFloat64(b bool) float64 {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+// enabledCollectors builds one Collector instance for every collector whose
+// flag is enabled.
+func enabledCollectors() map[string]Collector {
+	enabled := make(map[string]Collector)
+	for name, factory := range factories {
+		if enabledPtr := collectorFlags[name]; enabledPtr != nil && *enabledPtr {
+			enabled[name] = factory()
+		}
+	}
+	return enabled
+}
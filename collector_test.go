@@ -0,0 +1,34 @@
+package digitaloceanexporter
+
+import (
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+type fakeCollector struct{ name string }
+
+func (f *fakeCollector) Name() string { return f.name }
+
+func (f *fakeCollector) Describe(ch chan<- *prometheus.Desc) {}
+
+func (f *fakeCollector) Update(dos DigitalOceanSource) error { return nil }
+
+func (f *fakeCollector) Collect(ch chan<- prometheus.Metric) {}
+
+func TestEnabledCollectorsFiltersByFlagState(t *testing.T) {
+	const name = "test_enabled_collectors_fake"
+
+	registerCollector(name, false, func() Collector { return &fakeCollector{name: name} })
+	flag := collectorFlags[name]
+
+	*flag = false
+	if _, ok := enabledCollectors()[name]; ok {
+		t.Fatalf("enabledCollectors() included %q while its flag was disabled", name)
+	}
+
+	*flag = true
+	if _, ok := enabledCollectors()[name]; !ok {
+		t.Fatalf("enabledCollectors() did not include %q while its flag was enabled", name)
+	}
+}
@@ -0,0 +1,108 @@
+package digitaloceanexporter
+
+import "github.com/prometheus/client_golang/prometheus"
+
+func init() {
+	registerCollector("databases", true, newDatabaseCollector)
+}
+
+// A DatabaseCounter is a unique combination of properties that identify one
+// or more managed databases.
+type DatabaseCounter struct {
+	engine  string
+	version string
+	region  string
+	size    string
+	status  string
+}
+
+// DatabaseInfo describes a single managed Database for the purpose of
+// per-instance info metrics.
+type DatabaseInfo struct {
+	ID      string
+	Name    string
+	Engine  string
+	Version string
+	Region  string
+	Size    string
+	Status  string
+}
+
+// A databaseCollector collects metrics about managed Databases.
+type databaseCollector struct {
+	desc *prometheus.Desc
+	info *prometheus.Desc
+
+	databases map[DatabaseCounter]int
+	details   []DatabaseInfo
+}
+
+func newDatabaseCollector() Collector {
+	return &databaseCollector{
+		desc: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, "databases", "count"),
+			"Number of managed Databases by engine, version, region, size, and status.",
+			[]string{"engine", "version", "region", "size", "status"},
+			nil,
+		),
+		info: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, "database", "info"),
+			"Metadata about a managed Database. Constant 1.",
+			[]string{"id", "name", "engine", "version", "region", "size", "status"},
+			nil,
+		),
+	}
+}
+
+func (c *databaseCollector) Name() string { return "databases" }
+
+func (c *databaseCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.desc
+	ch <- c.info
+}
+
+func (c *databaseCollector) Update(dos DigitalOceanSource) error {
+	databases, err := dos.Databases()
+	if err != nil {
+		return err
+	}
+
+	details, err := dos.DatabaseDetails()
+	if err != nil {
+		return err
+	}
+
+	c.databases = databases
+	c.details = details
+	return nil
+}
+
+func (c *databaseCollector) Collect(ch chan<- prometheus.Metric) {
+	for d, count := range c.databases {
+		ch <- prometheus.MustNewConstMetric(
+			c.desc,
+			prometheus.GaugeValue,
+			float64(count),
+			d.engine,
+			d.version,
+			d.region,
+			d.size,
+			d.status,
+		)
+	}
+
+	for _, d := range c.details {
+		ch <- prometheus.MustNewConstMetric(
+			c.info,
+			prometheus.GaugeValue,
+			1,
+			d.ID,
+			d.Name,
+			d.Engine,
+			d.Version,
+			d.Region,
+			d.Size,
+			d.Status,
+		)
+	}
+}
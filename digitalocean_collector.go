@@ -1,7 +1,8 @@
 package digitaloceanexporter
 
 import (
-	"log"
+	"log/slog"
+	"sync"
 
 	"github.com/prometheus/client_golang/prometheus"
 )
@@ -11,203 +12,100 @@ import (
 // *digitaloceanexporter.DigitalOceanService.
 type DigitalOceanSource interface {
 	Droplets() (map[DropletCounter]int, error)
+	DropletDetails() ([]DropletInfo, error)
 	FloatingIPs() (map[FlipCounter]int, error)
+	FloatingIPDetails() ([]FloatingIPInfo, error)
 	LoadBalancers() (map[LoadBalancerCounter]int, error)
+	LoadBalancerDetails() ([]LoadBalancerInfo, error)
 	Tags() (map[TagCounter]int, error)
 	Volumes() (map[VolumeCounter]int, error)
+	VolumeDetails() ([]VolumeInfo, error)
+
+	KubernetesClusters() (map[KubernetesClusterCounter]int, error)
+	KubernetesNodePools() (map[KubernetesNodePoolCounter]int, error)
+	Databases() (map[DatabaseCounter]int, error)
+	DatabaseDetails() ([]DatabaseInfo, error)
+	SpacesBuckets() (map[SpacesBucketCounter]int, error)
+	Snapshots() (map[SnapshotCounter]int, error)
+	Images() (map[ImageCounter]int, error)
+	Domains() (map[DomainCounter]int, error)
+	DomainRecords() (map[DomainRecordCounter]int, error)
+	Projects() (map[ProjectCounter]int, error)
+	Firewalls() (map[FirewallCounter]int, error)
+	CDNEndpoints() (map[CDNEndpointCounter]int, error)
+	VPCs() (map[VPCCounter]int, error)
 }
 
 // A DigitalOceanCollector is a Prometheus collector for metrics regarding
-// DigitalOcean.
+// DigitalOcean. It fans out to the set of Collectors enabled via
+// --collector.<name> flags.
 type DigitalOceanCollector struct {
-	Droplets      *prometheus.Desc
-	FloatingIPs   *prometheus.Desc
-	LoadBalancers *prometheus.Desc
-	Tags          *prometheus.Desc
-	Volumes       *prometheus.Desc
+	dos        DigitalOceanSource
+	logger     *slog.Logger
+	collectors map[string]Collector
 
-	dos DigitalOceanSource
+	scrapeSuccess *prometheus.Desc
+
+	// mu serializes scrapes. Each Collector's Update writes to fields that
+	// its own Collect then reads without further locking, so two scrapes
+	// running Update/Collect concurrently (promhttp.Handler does not
+	// serialize requests) would race on that state.
+	mu sync.Mutex
 }
 
 // Verify that DigitalOceanCollector implements the prometheus.Collector interface.
 var _ prometheus.Collector = &DigitalOceanCollector{}
 
 // NewDigitalOceanCollector creates a new DigitalOceanCollector which collects
-// metrics about resources in a DigitalOcean account.
-func NewDigitalOceanCollector(dos DigitalOceanSource) *DigitalOceanCollector {
+// metrics about resources in a DigitalOcean account using every collector
+// enabled on the command line. logger is used to report per-collector update
+// failures.
+func NewDigitalOceanCollector(dos DigitalOceanSource, logger *slog.Logger) *DigitalOceanCollector {
 	return &DigitalOceanCollector{
-		Droplets: prometheus.NewDesc(
-			prometheus.BuildFQName(namespace, "droplets", "count"),
-			"Number of Droplets by region, size, and status.",
-			[]string{"region", "size", "status"},
-			nil,
-		),
-		FloatingIPs: prometheus.NewDesc(
-			prometheus.BuildFQName(namespace, "floating_ips", "count"),
-			"Number of Floating IPs by region and status.",
-			[]string{"region", "status"},
-			nil,
-		),
-		LoadBalancers: prometheus.NewDesc(
-			prometheus.BuildFQName(namespace, "load_balancers", "count"),
-			"Number of Load Balancers by region and status.",
-			[]string{"region", "status"},
-			nil,
-		),
-		Tags: prometheus.NewDesc(
-			prometheus.BuildFQName(namespace, "tags", "count"),
-			"Count of tagged resources by name and resource type.",
-			[]string{"name", "resource_type"},
+		dos:        dos,
+		logger:     logger,
+		collectors: enabledCollectors(),
+		// Note: when dos is a *Cache, this can read 1 during an ongoing
+		// DigitalOcean API outage. A resource that has succeeded at least
+		// once keeps serving its last good value with a nil error (see
+		// Cache.refresh), so Update only returns an error for a resource
+		// that has never succeeded. Use cache_refresh_errors_total for a
+		// signal that isn't masked by stale-but-served data.
+		scrapeSuccess: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, "scrape", "collector_success"),
+			"Whether the given collector's last Update succeeded (1) or failed (0).",
+			[]string{"collector"},
 			nil,
 		),
-		Volumes: prometheus.NewDesc(
-			prometheus.BuildFQName(namespace, "volumes", "count"),
-			"Number of Volumes by region, size in GiB, and status.",
-			[]string{"region", "size", "status"},
-			nil,
-		),
-
-		dos: dos,
-	}
-}
-
-// collect begins a metrics collection task for all metrics related to
-// resources in a DigitalOcean account.
-func (c *DigitalOceanCollector) collect(ch chan<- prometheus.Metric) (*prometheus.Desc, error) {
-	if count, err := c.collectDropletCounts(ch); err != nil {
-		return count, err
-	}
-	if count, err := c.collectFipsCounts(ch); err != nil {
-		return count, err
-	}
-	if count, err := c.collectLoadBalancerCounts(ch); err != nil {
-		return count, err
-	}
-	if count, err := c.collectTagCounts(ch); err != nil {
-		return count, err
-	}
-	if count, err := c.collectVolumeCounts(ch); err != nil {
-		return count, err
-	}
-
-	return nil, nil
-}
-
-func (c *DigitalOceanCollector) collectDropletCounts(ch chan<- prometheus.Metric) (*prometheus.Desc, error) {
-	droplets, err := c.dos.Droplets()
-	if err != nil {
-		return c.Droplets, err
-	}
-
-	for d, count := range droplets {
-		ch <- prometheus.MustNewConstMetric(
-			c.Droplets,
-			prometheus.GaugeValue,
-			float64(count),
-			d.region,
-			d.size,
-			d.status,
-		)
-	}
-
-	return nil, nil
-}
-
-func (c *DigitalOceanCollector) collectFipsCounts(ch chan<- prometheus.Metric) (*prometheus.Desc, error) {
-	fips, err := c.dos.FloatingIPs()
-	if err != nil {
-		return c.FloatingIPs, err
-	}
-
-	for fip, count := range fips {
-		ch <- prometheus.MustNewConstMetric(
-			c.FloatingIPs,
-			prometheus.GaugeValue,
-			float64(count),
-			fip.region,
-			fip.status,
-		)
 	}
-
-	return nil, nil
 }
 
-func (c *DigitalOceanCollector) collectLoadBalancerCounts(ch chan<- prometheus.Metric) (*prometheus.Desc, error) {
-	fips, err := c.dos.LoadBalancers()
-	if err != nil {
-		return c.FloatingIPs, err
-	}
-
-	for fip, count := range fips {
-		ch <- prometheus.MustNewConstMetric(
-			c.LoadBalancers,
-			prometheus.GaugeValue,
-			float64(count),
-			fip.region,
-			fip.status,
-		)
-	}
-
-	return nil, nil
-}
-
-func (c *DigitalOceanCollector) collectTagCounts(ch chan<- prometheus.Metric) (*prometheus.Desc, error) {
-	tags, err := c.dos.Tags()
-	if err != nil {
-		return c.Tags, err
-	}
-
-	for t, count := range tags {
-		ch <- prometheus.MustNewConstMetric(
-			c.Tags,
-			prometheus.GaugeValue,
-			float64(count),
-			t.name,
-			t.resourceType,
-		)
-	}
-
-	return nil, nil
-}
-
-func (c *DigitalOceanCollector) collectVolumeCounts(ch chan<- prometheus.Metric) (*prometheus.Desc, error) {
-	volumes, err := c.dos.Volumes()
-	if err != nil {
-		return c.Volumes, err
-	}
-
-	for v, count := range volumes {
-		ch <- prometheus.MustNewConstMetric(
-			c.Volumes,
-			prometheus.GaugeValue,
-			float64(count),
-			v.region,
-			v.size,
-			v.status,
-		)
-	}
-
-	return nil, nil
-}
-
-// Describe sends the descriptors of each metric over to the provided channel.
-// The corresponding metric values are sent separately.
+// Describe sends the descriptors of every enabled collector's metrics, plus
+// the scrape_collector_success descriptor, over to the provided channel. The
+// corresponding metric values are sent separately.
 func (c *DigitalOceanCollector) Describe(ch chan<- *prometheus.Desc) {
-	ds := []*prometheus.Desc{
-		c.Droplets,
-	}
-
-	for _, d := range ds {
-		ch <- d
+	for _, col := range c.collectors {
+		col.Describe(ch)
 	}
+	ch <- c.scrapeSuccess
 }
 
-// Collect sends the metric values for each metric pertaining to the DigitalOcean
-// resources to the provided prometheus Metric channel.
+// Collect updates and then sends the metric values for each enabled
+// collector to the provided prometheus Metric channel, along with whether
+// each collector's Update succeeded. Concurrent scrapes are serialized so
+// that a Collector's Update and Collect always run as one atomic step.
 func (c *DigitalOceanCollector) Collect(ch chan<- prometheus.Metric) {
-	if desc, err := c.collect(ch); err != nil {
-		log.Printf("[ERROR] failed collecting DigitalOcean metric %v: %v", desc, err)
-		ch <- prometheus.NewInvalidMetric(desc, err)
-		return
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for name, col := range c.collectors {
+		err := col.Update(c.dos)
+		ch <- prometheus.MustNewConstMetric(c.scrapeSuccess, prometheus.GaugeValue, boolToFloat64(err == nil), name)
+
+		if err != nil {
+			c.logger.Error("failed collecting DigitalOcean metrics", "collector", name, "err", err)
+			continue
+		}
+		col.Collect(ch)
 	}
 }
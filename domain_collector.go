@@ -0,0 +1,89 @@
+package digitaloceanexporter
+
+import "github.com/prometheus/client_golang/prometheus"
+
+func init() {
+	registerCollector("domains", true, newDomainCollector)
+}
+
+// A DomainCounter identifies a single domain.
+type DomainCounter struct {
+	name string
+}
+
+// A DomainRecordCounter is a unique combination of properties that identify
+// one or more domain records.
+type DomainRecordCounter struct {
+	domain string
+	typ    string
+}
+
+// A domainCollector collects metrics about Domains and their records.
+type domainCollector struct {
+	domains *prometheus.Desc
+	records *prometheus.Desc
+
+	domainCounts map[DomainCounter]int
+	recordCounts map[DomainRecordCounter]int
+}
+
+func newDomainCollector() Collector {
+	return &domainCollector{
+		domains: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, "domains", "count"),
+			"Number of Domains by name.",
+			[]string{"name"},
+			nil,
+		),
+		records: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, "domain_records", "count"),
+			"Number of Domain records by domain and record type.",
+			[]string{"domain", "type"},
+			nil,
+		),
+	}
+}
+
+func (c *domainCollector) Name() string { return "domains" }
+
+func (c *domainCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.domains
+	ch <- c.records
+}
+
+func (c *domainCollector) Update(dos DigitalOceanSource) error {
+	domains, err := dos.Domains()
+	if err != nil {
+		return err
+	}
+
+	records, err := dos.DomainRecords()
+	if err != nil {
+		return err
+	}
+
+	c.domainCounts = domains
+	c.recordCounts = records
+	return nil
+}
+
+func (c *domainCollector) Collect(ch chan<- prometheus.Metric) {
+	for d, count := range c.domainCounts {
+		ch <- prometheus.MustNewConstMetric(
+			c.domains,
+			prometheus.GaugeValue,
+			float64(count),
+			d.name,
+		)
+	}
+
+	for r, count := range c.recordCounts {
+		ch <- prometheus.MustNewConstMetric(
+			c.records,
+			prometheus.GaugeValue,
+			float64(count),
+			r.domain,
+			r.typ,
+		)
+	}
+}
@@ -0,0 +1,159 @@
+package digitaloceanexporter
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+func init() {
+	registerCollector("droplets", true, newDropletCollector)
+}
+
+// DropletInfo describes a single Droplet for the purpose of per-instance
+// info and resource-usage metrics.
+type DropletInfo struct {
+	ID           int
+	Name         string
+	Region       string
+	Size         string
+	Status       string
+	Image        string
+	VPC          string
+	Tags         []string
+	CreatedAt    time.Time
+	MemoryBytes  int64
+	VCPUs        int
+	DiskBytes    int64
+	PriceMonthly float64
+}
+
+// A dropletCollector collects metrics about Droplets.
+type dropletCollector struct {
+	desc         *prometheus.Desc
+	info         *prometheus.Desc
+	memoryBytes  *prometheus.Desc
+	vcpus        *prometheus.Desc
+	diskBytes    *prometheus.Desc
+	priceMonthly *prometheus.Desc
+	ageSeconds   *prometheus.Desc
+
+	droplets map[DropletCounter]int
+	details  []DropletInfo
+}
+
+func newDropletCollector() Collector {
+	dropletLabels := []string{"id", "name", "region", "size", "status", "image", "vpc", "tags"}
+
+	return &dropletCollector{
+		desc: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, "droplets", "count"),
+			"Number of Droplets by region, size, and status.",
+			[]string{"region", "size", "status"},
+			nil,
+		),
+		info: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, "droplet", "info"),
+			"Metadata about a Droplet. Constant 1.",
+			append(append([]string{}, dropletLabels...), "created_at"),
+			nil,
+		),
+		memoryBytes: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, "droplet", "memory_bytes"),
+			"Memory allocated to a Droplet, in bytes.",
+			[]string{"id"},
+			nil,
+		),
+		vcpus: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, "droplet", "vcpus"),
+			"Number of vCPUs allocated to a Droplet.",
+			[]string{"id"},
+			nil,
+		),
+		diskBytes: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, "droplet", "disk_bytes"),
+			"Disk space allocated to a Droplet, in bytes.",
+			[]string{"id"},
+			nil,
+		),
+		priceMonthly: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, "droplet", "price_monthly"),
+			"Monthly price of a Droplet, in US dollars.",
+			[]string{"id"},
+			nil,
+		),
+		ageSeconds: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, "droplet", "age_seconds"),
+			"Time since a Droplet was created, in seconds.",
+			[]string{"id"},
+			nil,
+		),
+	}
+}
+
+func (c *dropletCollector) Name() string { return "droplets" }
+
+func (c *dropletCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.desc
+	ch <- c.info
+	ch <- c.memoryBytes
+	ch <- c.vcpus
+	ch <- c.diskBytes
+	ch <- c.priceMonthly
+	ch <- c.ageSeconds
+}
+
+func (c *dropletCollector) Update(dos DigitalOceanSource) error {
+	droplets, err := dos.Droplets()
+	if err != nil {
+		return err
+	}
+
+	details, err := dos.DropletDetails()
+	if err != nil {
+		return err
+	}
+
+	c.droplets = droplets
+	c.details = details
+	return nil
+}
+
+func (c *dropletCollector) Collect(ch chan<- prometheus.Metric) {
+	for d, count := range c.droplets {
+		ch <- prometheus.MustNewConstMetric(
+			c.desc,
+			prometheus.GaugeValue,
+			float64(count),
+			d.region,
+			d.size,
+			d.status,
+		)
+	}
+
+	for _, d := range c.details {
+		id := strconv.Itoa(d.ID)
+
+		ch <- prometheus.MustNewConstMetric(
+			c.info,
+			prometheus.GaugeValue,
+			1,
+			id,
+			d.Name,
+			d.Region,
+			d.Size,
+			d.Status,
+			d.Image,
+			d.VPC,
+			strings.Join(d.Tags, ","),
+			d.CreatedAt.Format(time.RFC3339),
+		)
+		ch <- prometheus.MustNewConstMetric(c.memoryBytes, prometheus.GaugeValue, float64(d.MemoryBytes), id)
+		ch <- prometheus.MustNewConstMetric(c.vcpus, prometheus.GaugeValue, float64(d.VCPUs), id)
+		ch <- prometheus.MustNewConstMetric(c.diskBytes, prometheus.GaugeValue, float64(d.DiskBytes), id)
+		ch <- prometheus.MustNewConstMetric(c.priceMonthly, prometheus.GaugeValue, d.PriceMonthly, id)
+		ch <- prometheus.MustNewConstMetric(c.ageSeconds, prometheus.GaugeValue, time.Since(d.CreatedAt).Seconds(), id)
+	}
+}
@@ -0,0 +1,70 @@
+package digitaloceanexporter
+
+import "github.com/prometheus/client_golang/prometheus"
+
+func init() {
+	registerCollector("firewalls", true, newFirewallCollector)
+}
+
+// A FirewallCounter is a unique combination of properties that identify one
+// or more Firewalls. rules holds the combined number of inbound and
+// outbound rules, used as a metric value rather than a label to avoid
+// unbounded cardinality.
+type FirewallCounter struct {
+	status string
+	rules  int
+}
+
+// A firewallCollector collects metrics about Firewalls.
+type firewallCollector struct {
+	desc *prometheus.Desc
+
+	firewalls map[FirewallCounter]int
+}
+
+func newFirewallCollector() Collector {
+	return &firewallCollector{
+		desc: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, "firewall_rules", "count"),
+			"Number of Firewall rules by firewall status.",
+			[]string{"status"},
+			nil,
+		),
+	}
+}
+
+func (c *firewallCollector) Name() string { return "firewalls" }
+
+func (c *firewallCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.desc
+}
+
+func (c *firewallCollector) Update(dos DigitalOceanSource) error {
+	firewalls, err := dos.Firewalls()
+	if err != nil {
+		return err
+	}
+
+	c.firewalls = firewalls
+	return nil
+}
+
+func (c *firewallCollector) Collect(ch chan<- prometheus.Metric) {
+	// Several FirewallCounters can share a status but differ in rules, so
+	// the per-status rule counts must be summed before emitting: the desc
+	// only carries a "status" label, and emitting one sample per
+	// (status, rules) pair would produce duplicate timeseries.
+	ruleCountByStatus := make(map[string]int, len(c.firewalls))
+	for f, count := range c.firewalls {
+		ruleCountByStatus[f.status] += f.rules * count
+	}
+
+	for status, rules := range ruleCountByStatus {
+		ch <- prometheus.MustNewConstMetric(
+			c.desc,
+			prometheus.GaugeValue,
+			float64(rules),
+			status,
+		)
+	}
+}
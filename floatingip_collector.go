@@ -0,0 +1,86 @@
+package digitaloceanexporter
+
+import "github.com/prometheus/client_golang/prometheus"
+
+func init() {
+	registerCollector("floating_ips", true, newFloatingIPCollector)
+}
+
+// FloatingIPInfo describes a single Floating IP for the purpose of
+// per-instance assignment metrics.
+type FloatingIPInfo struct {
+	IP       string
+	Region   string
+	Assigned bool
+}
+
+// A floatingIPCollector collects metrics about Floating IPs.
+type floatingIPCollector struct {
+	desc     *prometheus.Desc
+	assigned *prometheus.Desc
+
+	fips    map[FlipCounter]int
+	details []FloatingIPInfo
+}
+
+func newFloatingIPCollector() Collector {
+	return &floatingIPCollector{
+		desc: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, "floating_ips", "count"),
+			"Number of Floating IPs by region and status.",
+			[]string{"region", "status"},
+			nil,
+		),
+		assigned: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, "floating_ip", "assigned"),
+			"Whether a Floating IP is assigned to a Droplet (1) or not (0).",
+			[]string{"ip", "region"},
+			nil,
+		),
+	}
+}
+
+func (c *floatingIPCollector) Name() string { return "floating_ips" }
+
+func (c *floatingIPCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.desc
+	ch <- c.assigned
+}
+
+func (c *floatingIPCollector) Update(dos DigitalOceanSource) error {
+	fips, err := dos.FloatingIPs()
+	if err != nil {
+		return err
+	}
+
+	details, err := dos.FloatingIPDetails()
+	if err != nil {
+		return err
+	}
+
+	c.fips = fips
+	c.details = details
+	return nil
+}
+
+func (c *floatingIPCollector) Collect(ch chan<- prometheus.Metric) {
+	for fip, count := range c.fips {
+		ch <- prometheus.MustNewConstMetric(
+			c.desc,
+			prometheus.GaugeValue,
+			float64(count),
+			fip.region,
+			fip.status,
+		)
+	}
+
+	for _, fip := range c.details {
+		ch <- prometheus.MustNewConstMetric(
+			c.assigned,
+			prometheus.GaugeValue,
+			boolToFloat64(fip.Assigned),
+			fip.IP,
+			fip.Region,
+		)
+	}
+}
@@ -0,0 +1,62 @@
+package digitaloceanexporter
+
+import "github.com/prometheus/client_golang/prometheus"
+
+func init() {
+	registerCollector("images", true, newImageCollector)
+}
+
+// An ImageCounter is a unique combination of properties that identify one or
+// more custom images.
+type ImageCounter struct {
+	region       string
+	distribution string
+	status       string
+}
+
+// An imageCollector collects metrics about custom Images.
+type imageCollector struct {
+	desc *prometheus.Desc
+
+	images map[ImageCounter]int
+}
+
+func newImageCollector() Collector {
+	return &imageCollector{
+		desc: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, "images", "count"),
+			"Number of custom Images by region, distribution, and status.",
+			[]string{"region", "distribution", "status"},
+			nil,
+		),
+	}
+}
+
+func (c *imageCollector) Name() string { return "images" }
+
+func (c *imageCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.desc
+}
+
+func (c *imageCollector) Update(dos DigitalOceanSource) error {
+	images, err := dos.Images()
+	if err != nil {
+		return err
+	}
+
+	c.images = images
+	return nil
+}
+
+func (c *imageCollector) Collect(ch chan<- prometheus.Metric) {
+	for i, count := range c.images {
+		ch <- prometheus.MustNewConstMetric(
+			c.desc,
+			prometheus.GaugeValue,
+			float64(count),
+			i.region,
+			i.distribution,
+			i.status,
+		)
+	}
+}
@@ -0,0 +1,95 @@
+package digitaloceanexporter
+
+import "github.com/prometheus/client_golang/prometheus"
+
+func init() {
+	registerCollector("kubernetes", true, newKubernetesCollector)
+}
+
+// A KubernetesClusterCounter is a unique combination of properties that
+// identify one or more Kubernetes clusters.
+type KubernetesClusterCounter struct {
+	region  string
+	version string
+	status  string
+}
+
+// A KubernetesNodePoolCounter is a unique combination of properties that
+// identify one or more Kubernetes node pools.
+type KubernetesNodePoolCounter struct {
+	cluster string
+	size    string
+}
+
+// A kubernetesCollector collects metrics about managed Kubernetes clusters
+// and their node pools.
+type kubernetesCollector struct {
+	clusters  *prometheus.Desc
+	nodePools *prometheus.Desc
+
+	clusterCounts  map[KubernetesClusterCounter]int
+	nodePoolCounts map[KubernetesNodePoolCounter]int
+}
+
+func newKubernetesCollector() Collector {
+	return &kubernetesCollector{
+		clusters: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, "kubernetes_clusters", "count"),
+			"Number of Kubernetes clusters by region, version, and status.",
+			[]string{"region", "version", "status"},
+			nil,
+		),
+		nodePools: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, "kubernetes_node_pools", "count"),
+			"Number of Kubernetes node pools by cluster and node size.",
+			[]string{"cluster", "size"},
+			nil,
+		),
+	}
+}
+
+func (c *kubernetesCollector) Name() string { return "kubernetes" }
+
+func (c *kubernetesCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.clusters
+	ch <- c.nodePools
+}
+
+func (c *kubernetesCollector) Update(dos DigitalOceanSource) error {
+	clusters, err := dos.KubernetesClusters()
+	if err != nil {
+		return err
+	}
+
+	nodePools, err := dos.KubernetesNodePools()
+	if err != nil {
+		return err
+	}
+
+	c.clusterCounts = clusters
+	c.nodePoolCounts = nodePools
+	return nil
+}
+
+func (c *kubernetesCollector) Collect(ch chan<- prometheus.Metric) {
+	for k, count := range c.clusterCounts {
+		ch <- prometheus.MustNewConstMetric(
+			c.clusters,
+			prometheus.GaugeValue,
+			float64(count),
+			k.region,
+			k.version,
+			k.status,
+		)
+	}
+
+	for np, count := range c.nodePoolCounts {
+		ch <- prometheus.MustNewConstMetric(
+			c.nodePools,
+			prometheus.GaugeValue,
+			float64(count),
+			np.cluster,
+			np.size,
+		)
+	}
+}
@@ -0,0 +1,105 @@
+package digitaloceanexporter
+
+import "github.com/prometheus/client_golang/prometheus"
+
+func init() {
+	registerCollector("load_balancers", true, newLoadBalancerCollector)
+}
+
+// LoadBalancerInfo describes a single Load Balancer for the purpose of
+// per-instance backend health metrics.
+type LoadBalancerInfo struct {
+	ID                string
+	Name              string
+	Region            string
+	HealthyBackends   int
+	UnhealthyBackends int
+}
+
+// A loadBalancerCollector collects metrics about Load Balancers.
+type loadBalancerCollector struct {
+	desc              *prometheus.Desc
+	healthyBackends   *prometheus.Desc
+	unhealthyBackends *prometheus.Desc
+
+	loadBalancers map[LoadBalancerCounter]int
+	details       []LoadBalancerInfo
+}
+
+func newLoadBalancerCollector() Collector {
+	return &loadBalancerCollector{
+		desc: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, "load_balancers", "count"),
+			"Number of Load Balancers by region and status.",
+			[]string{"region", "status"},
+			nil,
+		),
+		healthyBackends: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, "load_balancer", "healthy_backends"),
+			"Number of healthy backend Droplets behind a Load Balancer.",
+			[]string{"id", "name", "region"},
+			nil,
+		),
+		unhealthyBackends: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, "load_balancer", "unhealthy_backends"),
+			"Number of unhealthy backend Droplets behind a Load Balancer.",
+			[]string{"id", "name", "region"},
+			nil,
+		),
+	}
+}
+
+func (c *loadBalancerCollector) Name() string { return "load_balancers" }
+
+func (c *loadBalancerCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.desc
+	ch <- c.healthyBackends
+	ch <- c.unhealthyBackends
+}
+
+func (c *loadBalancerCollector) Update(dos DigitalOceanSource) error {
+	loadBalancers, err := dos.LoadBalancers()
+	if err != nil {
+		return err
+	}
+
+	details, err := dos.LoadBalancerDetails()
+	if err != nil {
+		return err
+	}
+
+	c.loadBalancers = loadBalancers
+	c.details = details
+	return nil
+}
+
+func (c *loadBalancerCollector) Collect(ch chan<- prometheus.Metric) {
+	for lb, count := range c.loadBalancers {
+		ch <- prometheus.MustNewConstMetric(
+			c.desc,
+			prometheus.GaugeValue,
+			float64(count),
+			lb.region,
+			lb.status,
+		)
+	}
+
+	for _, lb := range c.details {
+		ch <- prometheus.MustNewConstMetric(
+			c.healthyBackends,
+			prometheus.GaugeValue,
+			float64(lb.HealthyBackends),
+			lb.ID,
+			lb.Name,
+			lb.Region,
+		)
+		ch <- prometheus.MustNewConstMetric(
+			c.unhealthyBackends,
+			prometheus.GaugeValue,
+			float64(lb.UnhealthyBackends),
+			lb.ID,
+			lb.Name,
+			lb.Region,
+		)
+	}
+}
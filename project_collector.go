@@ -0,0 +1,58 @@
+package digitaloceanexporter
+
+import "github.com/prometheus/client_golang/prometheus"
+
+func init() {
+	registerCollector("projects", true, newProjectCollector)
+}
+
+// A ProjectCounter is a unique combination of properties that identify one
+// or more Projects.
+type ProjectCounter struct {
+	purpose string
+}
+
+// A projectCollector collects metrics about Projects.
+type projectCollector struct {
+	desc *prometheus.Desc
+
+	projects map[ProjectCounter]int
+}
+
+func newProjectCollector() Collector {
+	return &projectCollector{
+		desc: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, "projects", "count"),
+			"Number of Projects by purpose.",
+			[]string{"purpose"},
+			nil,
+		),
+	}
+}
+
+func (c *projectCollector) Name() string { return "projects" }
+
+func (c *projectCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.desc
+}
+
+func (c *projectCollector) Update(dos DigitalOceanSource) error {
+	projects, err := dos.Projects()
+	if err != nil {
+		return err
+	}
+
+	c.projects = projects
+	return nil
+}
+
+func (c *projectCollector) Collect(ch chan<- prometheus.Metric) {
+	for p, count := range c.projects {
+		ch <- prometheus.MustNewConstMetric(
+			c.desc,
+			prometheus.GaugeValue,
+			float64(count),
+			p.purpose,
+		)
+	}
+}
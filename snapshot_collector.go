@@ -0,0 +1,60 @@
+package digitaloceanexporter
+
+import "github.com/prometheus/client_golang/prometheus"
+
+func init() {
+	registerCollector("snapshots", true, newSnapshotCollector)
+}
+
+// A SnapshotCounter is a unique combination of properties that identify one
+// or more snapshots.
+type SnapshotCounter struct {
+	resourceType string
+	region       string
+}
+
+// A snapshotCollector collects metrics about Droplet and Volume snapshots.
+type snapshotCollector struct {
+	desc *prometheus.Desc
+
+	snapshots map[SnapshotCounter]int
+}
+
+func newSnapshotCollector() Collector {
+	return &snapshotCollector{
+		desc: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, "snapshots", "count"),
+			"Number of snapshots by resource type and region.",
+			[]string{"resource_type", "region"},
+			nil,
+		),
+	}
+}
+
+func (c *snapshotCollector) Name() string { return "snapshots" }
+
+func (c *snapshotCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.desc
+}
+
+func (c *snapshotCollector) Update(dos DigitalOceanSource) error {
+	snapshots, err := dos.Snapshots()
+	if err != nil {
+		return err
+	}
+
+	c.snapshots = snapshots
+	return nil
+}
+
+func (c *snapshotCollector) Collect(ch chan<- prometheus.Metric) {
+	for s, count := range c.snapshots {
+		ch <- prometheus.MustNewConstMetric(
+			c.desc,
+			prometheus.GaugeValue,
+			float64(count),
+			s.resourceType,
+			s.region,
+		)
+	}
+}
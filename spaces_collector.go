@@ -0,0 +1,58 @@
+package digitaloceanexporter
+
+import "github.com/prometheus/client_golang/prometheus"
+
+func init() {
+	registerCollector("spaces", true, newSpacesCollector)
+}
+
+// A SpacesBucketCounter is a unique combination of properties that identify
+// one or more Spaces buckets.
+type SpacesBucketCounter struct {
+	region string
+}
+
+// A spacesCollector collects metrics about Spaces buckets.
+type spacesCollector struct {
+	desc *prometheus.Desc
+
+	buckets map[SpacesBucketCounter]int
+}
+
+func newSpacesCollector() Collector {
+	return &spacesCollector{
+		desc: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, "spaces_buckets", "count"),
+			"Number of Spaces buckets by region.",
+			[]string{"region"},
+			nil,
+		),
+	}
+}
+
+func (c *spacesCollector) Name() string { return "spaces" }
+
+func (c *spacesCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.desc
+}
+
+func (c *spacesCollector) Update(dos DigitalOceanSource) error {
+	buckets, err := dos.SpacesBuckets()
+	if err != nil {
+		return err
+	}
+
+	c.buckets = buckets
+	return nil
+}
+
+func (c *spacesCollector) Collect(ch chan<- prometheus.Metric) {
+	for b, count := range c.buckets {
+		ch <- prometheus.MustNewConstMetric(
+			c.desc,
+			prometheus.GaugeValue,
+			float64(count),
+			b.region,
+		)
+	}
+}
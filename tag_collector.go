@@ -0,0 +1,53 @@
+package digitaloceanexporter
+
+import "github.com/prometheus/client_golang/prometheus"
+
+func init() {
+	registerCollector("tags", true, newTagCollector)
+}
+
+// A tagCollector collects metrics about tagged resources.
+type tagCollector struct {
+	desc *prometheus.Desc
+
+	tags map[TagCounter]int
+}
+
+func newTagCollector() Collector {
+	return &tagCollector{
+		desc: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, "tags", "count"),
+			"Count of tagged resources by name and resource type.",
+			[]string{"name", "resource_type"},
+			nil,
+		),
+	}
+}
+
+func (c *tagCollector) Name() string { return "tags" }
+
+func (c *tagCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.desc
+}
+
+func (c *tagCollector) Update(dos DigitalOceanSource) error {
+	tags, err := dos.Tags()
+	if err != nil {
+		return err
+	}
+
+	c.tags = tags
+	return nil
+}
+
+func (c *tagCollector) Collect(ch chan<- prometheus.Metric) {
+	for t, count := range c.tags {
+		ch <- prometheus.MustNewConstMetric(
+			c.desc,
+			prometheus.GaugeValue,
+			float64(count),
+			t.name,
+			t.resourceType,
+		)
+	}
+}
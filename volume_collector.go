@@ -0,0 +1,101 @@
+package digitaloceanexporter
+
+import "github.com/prometheus/client_golang/prometheus"
+
+func init() {
+	registerCollector("volumes", true, newVolumeCollector)
+}
+
+// VolumeInfo describes a single Volume for the purpose of per-instance info
+// and usage metrics.
+type VolumeInfo struct {
+	ID        string
+	Name      string
+	Region    string
+	SizeBytes int64
+	Attached  bool
+}
+
+// A volumeCollector collects metrics about Volumes.
+type volumeCollector struct {
+	desc      *prometheus.Desc
+	info      *prometheus.Desc
+	sizeBytes *prometheus.Desc
+	attached  *prometheus.Desc
+
+	volumes map[VolumeCounter]int
+	details []VolumeInfo
+}
+
+func newVolumeCollector() Collector {
+	return &volumeCollector{
+		desc: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, "volumes", "count"),
+			"Number of Volumes by region, size in GiB, and status.",
+			[]string{"region", "size", "status"},
+			nil,
+		),
+		info: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, "volume", "info"),
+			"Metadata about a Volume. Constant 1.",
+			[]string{"id", "name", "region"},
+			nil,
+		),
+		sizeBytes: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, "volume", "size_bytes"),
+			"Size of a Volume, in bytes.",
+			[]string{"id"},
+			nil,
+		),
+		attached: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, "volume", "attached"),
+			"Whether a Volume is attached to a Droplet (1) or not (0).",
+			[]string{"id"},
+			nil,
+		),
+	}
+}
+
+func (c *volumeCollector) Name() string { return "volumes" }
+
+func (c *volumeCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.desc
+	ch <- c.info
+	ch <- c.sizeBytes
+	ch <- c.attached
+}
+
+func (c *volumeCollector) Update(dos DigitalOceanSource) error {
+	volumes, err := dos.Volumes()
+	if err != nil {
+		return err
+	}
+
+	details, err := dos.VolumeDetails()
+	if err != nil {
+		return err
+	}
+
+	c.volumes = volumes
+	c.details = details
+	return nil
+}
+
+func (c *volumeCollector) Collect(ch chan<- prometheus.Metric) {
+	for v, count := range c.volumes {
+		ch <- prometheus.MustNewConstMetric(
+			c.desc,
+			prometheus.GaugeValue,
+			float64(count),
+			v.region,
+			v.size,
+			v.status,
+		)
+	}
+
+	for _, v := range c.details {
+		ch <- prometheus.MustNewConstMetric(c.info, prometheus.GaugeValue, 1, v.ID, v.Name, v.Region)
+		ch <- prometheus.MustNewConstMetric(c.sizeBytes, prometheus.GaugeValue, float64(v.SizeBytes), v.ID)
+		ch <- prometheus.MustNewConstMetric(c.attached, prometheus.GaugeValue, boolToFloat64(v.Attached), v.ID)
+	}
+}
@@ -0,0 +1,60 @@
+package digitaloceanexporter
+
+import "github.com/prometheus/client_golang/prometheus"
+
+func init() {
+	registerCollector("vpcs", true, newVPCCollector)
+}
+
+// A VPCCounter is a unique combination of properties that identify one or
+// more VPCs.
+type VPCCounter struct {
+	region    string
+	isDefault string
+}
+
+// A vpcCollector collects metrics about VPCs.
+type vpcCollector struct {
+	desc *prometheus.Desc
+
+	vpcs map[VPCCounter]int
+}
+
+func newVPCCollector() Collector {
+	return &vpcCollector{
+		desc: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, "vpcs", "count"),
+			"Number of VPCs by region and whether they are the account's default VPC for that region.",
+			[]string{"region", "default"},
+			nil,
+		),
+	}
+}
+
+func (c *vpcCollector) Name() string { return "vpcs" }
+
+func (c *vpcCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.desc
+}
+
+func (c *vpcCollector) Update(dos DigitalOceanSource) error {
+	vpcs, err := dos.VPCs()
+	if err != nil {
+		return err
+	}
+
+	c.vpcs = vpcs
+	return nil
+}
+
+func (c *vpcCollector) Collect(ch chan<- prometheus.Metric) {
+	for v, count := range c.vpcs {
+		ch <- prometheus.MustNewConstMetric(
+			c.desc,
+			prometheus.GaugeValue,
+			float64(count),
+			v.region,
+			v.isDefault,
+		)
+	}
+}